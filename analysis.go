@@ -0,0 +1,77 @@
+package scl
+
+import (
+	"math"
+	"reflect"
+)
+
+// perfectOctaveEpsilon is the tolerance, in cents, used when comparing a
+// pitch against a perfect octave (2/1).
+const perfectOctaveEpsilon = 1e-6
+
+// Degree returns the number of pitches in the scale, i.e. its degree.
+func (s Scale) Degree() int {
+	return len(s.Pitches)
+}
+
+// Octave returns the scale's final pitch, which is conventionally its
+// repeat interval, or nil if the scale has no pitches.
+func (s Scale) Octave() Pitch {
+	if len(s.Pitches) == 0 {
+		return nil
+	}
+	return s.Pitches[len(s.Pitches)-1]
+}
+
+// PerfectOctave reports whether the scale's final pitch is a perfect
+// octave (2/1), within a small epsilon.
+func (s Scale) PerfectOctave() bool {
+	o := s.Octave()
+	if o == nil {
+		return false
+	}
+	return math.Abs(o.Cents()-1200) <= perfectOctaveEpsilon
+}
+
+// PitchCounts returns the number of CentsPitch and RatioPitch values in
+// the scale, respectively.
+func (s Scale) PitchCounts() (cents, ratio int) {
+	for _, p := range s.Pitches {
+		switch p.(type) {
+		case CentsPitch:
+			cents++
+		case RatioPitch:
+			ratio++
+		}
+	}
+	return cents, ratio
+}
+
+// UniformPitchType returns the concrete type of the scale's pitches and
+// true if every pitch in the scale shares that type. It returns false for
+// an empty scale.
+func (s Scale) UniformPitchType() (reflect.Type, bool) {
+	if len(s.Pitches) == 0 {
+		return nil, false
+	}
+	t := reflect.TypeOf(s.Pitches[0])
+	for _, p := range s.Pitches[1:] {
+		if reflect.TypeOf(p) != t {
+			return nil, false
+		}
+	}
+	return t, true
+}
+
+// PredominantPitchType returns the concrete pitch type that occurs most
+// often in the scale. It returns nil for an empty scale.
+func (s Scale) PredominantPitchType() reflect.Type {
+	if len(s.Pitches) == 0 {
+		return nil
+	}
+	cents, ratio := s.PitchCounts()
+	if ratio > cents {
+		return reflect.TypeOf(RatioPitch{})
+	}
+	return reflect.TypeOf(CentsPitch(0))
+}
@@ -0,0 +1,77 @@
+package scl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDegree(t *testing.T) {
+	s := Scale{Pitches: []Pitch{CentsPitch(700), RatioPitch{2, 1}}}
+	if got, want := s.Degree(), 2; got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+	if got, want := (Scale{}).Degree(), 0; got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+}
+
+func TestOctave(t *testing.T) {
+	s := Scale{Pitches: []Pitch{CentsPitch(700), RatioPitch{2, 1}}}
+	if got, want := s.Octave(), Pitch(RatioPitch{2, 1}); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := (Scale{}).Octave(); got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+}
+
+func TestPerfectOctave(t *testing.T) {
+	tests := []struct {
+		s    Scale
+		want bool
+	}{
+		{Scale{Pitches: []Pitch{RatioPitch{2, 1}}}, true},
+		{Scale{Pitches: []Pitch{CentsPitch(1200)}}, true},
+		{Scale{Pitches: []Pitch{CentsPitch(1195)}}, false},
+		{Scale{}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.s.PerfectOctave(); got != tt.want {
+			t.Errorf("PerfectOctave(%v) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestPitchCounts(t *testing.T) {
+	s := Scale{Pitches: []Pitch{CentsPitch(700), RatioPitch{2, 1}, CentsPitch(1200)}}
+	cents, ratio := s.PitchCounts()
+	if cents != 2 || ratio != 1 {
+		t.Errorf("got cents=%d ratio=%d, want cents=2 ratio=1", cents, ratio)
+	}
+}
+
+func TestUniformPitchType(t *testing.T) {
+	uniform := Scale{Pitches: []Pitch{CentsPitch(700), CentsPitch(1200)}}
+	if typ, ok := uniform.UniformPitchType(); !ok || typ != reflect.TypeOf(CentsPitch(0)) {
+		t.Errorf("got (%v, %v), want (CentsPitch, true)", typ, ok)
+	}
+
+	mixed := Scale{Pitches: []Pitch{CentsPitch(700), RatioPitch{2, 1}}}
+	if _, ok := mixed.UniformPitchType(); ok {
+		t.Errorf("got ok=true for a mixed scale, want false")
+	}
+
+	if _, ok := (Scale{}).UniformPitchType(); ok {
+		t.Errorf("got ok=true for an empty scale, want false")
+	}
+}
+
+func TestPredominantPitchType(t *testing.T) {
+	s := Scale{Pitches: []Pitch{CentsPitch(700), RatioPitch{2, 1}, RatioPitch{3, 2}}}
+	if got, want := s.PredominantPitchType(), reflect.TypeOf(RatioPitch{}); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := (Scale{}).PredominantPitchType(); got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+}
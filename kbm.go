@@ -0,0 +1,195 @@
+package scl
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// A Mapping describes how scale degrees are assigned to MIDI note numbers,
+// as read from a Scala .kbm keyboard-mapping file. Paired with a Scale it
+// produces the frequency to sound for every MIDI note; see Scale.MIDIFreqs.
+type Mapping struct {
+	Size          int
+	FirstMIDI     int
+	LastMIDI      int
+	MiddleMIDI    int
+	ReferenceMIDI int
+	ReferenceFreq float64
+	FormalOctave  int
+	// Keys holds Size entries, each the scale degree a position in the
+	// repeating pattern maps to, or -1 if that key is unmapped ("x" in
+	// the file). A Size of 0 means no mapping pattern: MIDI notes map
+	// directly to scale degrees relative to MiddleMIDI.
+	Keys []int
+}
+
+// ReadMapping reads a Mapping from the given reader.
+// The input is assumed to be a file in kbm format; reading stops after the last mapping entry.
+func ReadMapping(r io.Reader) (Mapping, error) {
+	var m Mapping
+	d := newDataScanner(r)
+
+	ints := []*int{&m.Size, &m.FirstMIDI, &m.LastMIDI, &m.MiddleMIDI, &m.ReferenceMIDI}
+	for _, f := range ints {
+		line, i, ok := d.next()
+		if !ok {
+			return m, fmt.Errorf("unexpected EOF reading mapping header")
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(line))
+		if err != nil {
+			return m, fmt.Errorf("malformed value on line %d: %s", i, line)
+		}
+		*f = n
+	}
+
+	line, i, ok := d.next()
+	if !ok {
+		return m, fmt.Errorf("unexpected EOF reading reference frequency")
+	}
+	freq, err := strconv.ParseFloat(strings.TrimSpace(line), 64)
+	if err != nil {
+		return m, fmt.Errorf("malformed reference frequency on line %d: %s", i, line)
+	}
+	m.ReferenceFreq = freq
+
+	line, i, ok = d.next()
+	if !ok {
+		return m, fmt.Errorf("unexpected EOF reading formal octave")
+	}
+	octave, err := strconv.Atoi(strings.TrimSpace(line))
+	if err != nil {
+		return m, fmt.Errorf("malformed formal octave on line %d: %s", i, line)
+	}
+	m.FormalOctave = octave
+
+	for len(m.Keys) < m.Size {
+		line, i, ok := d.next()
+		if !ok {
+			return m, fmt.Errorf("expected %d mapping entries, got %d", m.Size, len(m.Keys))
+		}
+		line = strings.TrimSpace(line)
+		if line == "x" {
+			m.Keys = append(m.Keys, -1)
+			continue
+		}
+		k, err := strconv.Atoi(line)
+		if err != nil {
+			return m, fmt.Errorf("malformed mapping entry on line %d: %s", i, line)
+		}
+		m.Keys = append(m.Keys, k)
+	}
+	return m, d.err()
+}
+
+// WriteMapping writes a mapping to the given writer in kbm format.
+func WriteMapping(w io.Writer, m Mapping) error {
+	ints := []int{m.Size, m.FirstMIDI, m.LastMIDI, m.MiddleMIDI, m.ReferenceMIDI}
+	for _, f := range ints {
+		if _, err := fmt.Fprintln(w, f); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintln(w, m.ReferenceFreq); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w, m.FormalOctave); err != nil {
+		return err
+	}
+	for _, k := range m.Keys {
+		var err error
+		if k < 0 {
+			_, err = fmt.Fprintln(w, "x")
+		} else {
+			_, err = fmt.Fprintln(w, k)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MIDIFreqs applies the keyboard mapping m to s, returning the frequency to
+// sound for every MIDI note 0-127. Notes outside [m.FirstMIDI, m.LastMIDI]
+// or mapped to an unmapped key are left at 0.
+func (s Scale) MIDIFreqs(m Mapping) [128]float64 {
+	var freqs [128]float64
+	octave := m.FormalOctave
+	if octave <= 0 {
+		octave = len(s.Pitches)
+	}
+	if octave == 0 {
+		return freqs
+	}
+	ref, ok := m.key(octave, m.ReferenceMIDI)
+	if !ok {
+		return freqs
+	}
+	middleFreq := m.ReferenceFreq / s.ratio(ref, octave)
+	for note := m.FirstMIDI; note <= m.LastMIDI; note++ {
+		if note < 0 || note > 127 {
+			continue
+		}
+		k, ok := m.key(octave, note)
+		if !ok {
+			continue
+		}
+		freqs[note] = middleFreq * s.ratio(k, octave)
+	}
+	return freqs
+}
+
+// key returns the scale degree (relative to MiddleMIDI, reduced modulo
+// octave by ratio) that note maps to, and whether note is mapped at all.
+func (m Mapping) key(octave, note int) (int, bool) {
+	rel := note - m.MiddleMIDI
+	if m.Size <= 0 || len(m.Keys) == 0 {
+		return rel, true
+	}
+	oct := floorDiv(rel, m.Size)
+	pos := rel - oct*m.Size
+	if pos < 0 || pos >= len(m.Keys) {
+		return 0, false
+	}
+	k := m.Keys[pos]
+	if k < 0 {
+		return 0, false
+	}
+	return k + oct*octave, true
+}
+
+// ratio returns the frequency ratio of scale degree k, relative to the
+// scale's base, wrapping every octave steps using s.Pitches. Each wrap
+// transposes by the pitch at degree octave, or by 2/1 if the scale has
+// fewer than octave pitches.
+func (s Scale) ratio(k, octave int) float64 {
+	oct, deg := floorDivMod(k, octave)
+	r := 1.0
+	if deg > 0 && deg <= len(s.Pitches) {
+		r = s.Pitches[deg-1].Freq(1)
+	}
+	period := 2.0
+	if octave <= len(s.Pitches) {
+		period = s.Pitches[octave-1].Freq(1)
+	}
+	return r * math.Pow(period, float64(oct))
+}
+
+// floorDiv returns a divided by b, rounded toward negative infinity.
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// floorDivMod returns the quotient and remainder of a divided by b, with
+// the remainder always in [0, b) for positive b.
+func floorDivMod(a, b int) (q, r int) {
+	q = floorDiv(a, b)
+	return q, a - q*b
+}
@@ -0,0 +1,128 @@
+package scl
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+)
+
+const exampleLinearKBM = `! linear.kbm
+! no explicit keyboard mapping pattern, notes map directly to degrees
+0
+0
+127
+60
+69
+440.0
+2
+`
+
+func TestReadMapping(t *testing.T) {
+	m, err := ReadMapping(strings.NewReader(exampleLinearKBM))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Mapping{
+		Size:          0,
+		FirstMIDI:     0,
+		LastMIDI:      127,
+		MiddleMIDI:    60,
+		ReferenceMIDI: 69,
+		ReferenceFreq: 440.0,
+		FormalOctave:  2,
+	}
+	if m.Size != want.Size || m.FirstMIDI != want.FirstMIDI || m.LastMIDI != want.LastMIDI ||
+		m.MiddleMIDI != want.MiddleMIDI || m.ReferenceMIDI != want.ReferenceMIDI ||
+		m.ReferenceFreq != want.ReferenceFreq || m.FormalOctave != want.FormalOctave ||
+		len(m.Keys) != 0 {
+		t.Errorf("got %+v, want %+v", m, want)
+	}
+}
+
+func TestWriteMappingRoundTrip(t *testing.T) {
+	m := Mapping{
+		Size:          3,
+		FirstMIDI:     0,
+		LastMIDI:      127,
+		MiddleMIDI:    60,
+		ReferenceMIDI: 69,
+		ReferenceFreq: 440.0,
+		FormalOctave:  3,
+		Keys:          []int{0, -1, 2},
+	}
+	var buf bytes.Buffer
+	if err := WriteMapping(&buf, m); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadMapping(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Size != m.Size || got.FirstMIDI != m.FirstMIDI || got.LastMIDI != m.LastMIDI ||
+		got.MiddleMIDI != m.MiddleMIDI || got.ReferenceMIDI != m.ReferenceMIDI ||
+		got.ReferenceFreq != m.ReferenceFreq || got.FormalOctave != m.FormalOctave {
+		t.Errorf("got %+v, want %+v", got, m)
+	}
+	if len(got.Keys) != len(m.Keys) {
+		t.Fatalf("got %d keys, want %d", len(got.Keys), len(m.Keys))
+	}
+	for i := range m.Keys {
+		if got.Keys[i] != m.Keys[i] {
+			t.Errorf("key %d: got %d, want %d", i, got.Keys[i], m.Keys[i])
+		}
+	}
+}
+
+func TestMIDIFreqsLinear(t *testing.T) {
+	s := Scale{Pitches: []Pitch{CentsPitch(700), RatioPitch{2, 1}}}
+	m := Mapping{
+		FirstMIDI:     0,
+		LastMIDI:      127,
+		MiddleMIDI:    60,
+		ReferenceMIDI: 69,
+		ReferenceFreq: 440.0,
+		FormalOctave:  2,
+	}
+	freqs := s.MIDIFreqs(m)
+	want := map[int]float64{
+		58: 9.177023997418987,
+		60: 18.354047994837973,
+		61: 27.5,
+		62: 36.70809598967595,
+		69: 440.0,
+	}
+	for note, f := range want {
+		if got := freqs[note]; math.Abs(got-f) > 0.0001 {
+			t.Errorf("note %d: got %f, want %f", note, got, f)
+		}
+	}
+}
+
+func TestMIDIFreqsUnmappedKey(t *testing.T) {
+	s := Scale{Pitches: []Pitch{CentsPitch(700), RatioPitch{2, 1}}}
+	m := Mapping{
+		FirstMIDI:     0,
+		LastMIDI:      127,
+		MiddleMIDI:    60,
+		ReferenceMIDI: 60,
+		ReferenceFreq: 261.6256,
+		FormalOctave:  2,
+		Size:          2,
+		Keys:          []int{0, -1},
+	}
+	freqs := s.MIDIFreqs(m)
+	if got := freqs[61]; got != 0 {
+		t.Errorf("unmapped note 61: got %f, want 0", got)
+	}
+	if got, want := freqs[60], 261.6256; math.Abs(got-want) > 0.0001 {
+		t.Errorf("note 60: got %f, want %f", got, want)
+	}
+}
+
+func TestMappingKeyOutOfRange(t *testing.T) {
+	m := Mapping{Size: 4, Keys: []int{0, 1}}
+	if k, ok := m.key(2, 2); ok {
+		t.Errorf("got (%d, true), want ok=false when len(Keys) < Size", k)
+	}
+}
@@ -0,0 +1,83 @@
+package scl
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// A Library is a directory of Scala .scl files, such as the Huygens-Fokker
+// archive, that can be walked, loaded by name, or indexed for search.
+type Library struct {
+	Dir string
+}
+
+// Names returns the file names found in the library's directory.
+func (l *Library) Names() ([]string, error) {
+	dir, err := os.Open(l.Dir)
+	if err != nil {
+		return nil, err
+	}
+	defer dir.Close()
+	return dir.Readdirnames(-1)
+}
+
+// Load reads and parses the named file from the library's directory.
+func (l *Library) Load(name string) (Scale, error) {
+	f, err := os.Open(filepath.Join(l.Dir, name))
+	if err != nil {
+		return Scale{}, err
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// Walk calls fn once for every file in the library's directory, passing
+// the parsed Scale or the error encountered loading it. Walking stops and
+// returns the first non-nil error that fn returns.
+func (l *Library) Walk(fn func(name string, s Scale, err error) error) error {
+	names, err := l.Names()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		s, loadErr := l.Load(name)
+		if err := fn(name, s, loadErr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ScaleInfo summarizes a Scale without requiring a caller to load every
+// file's full pitch list, so a library of thousands of scales can be
+// searched by degree or pitch type.
+type ScaleInfo struct {
+	Name          string
+	Description   string
+	Degree        int
+	CentsCount    int
+	RatioCount    int
+	PerfectOctave bool
+}
+
+// Index builds a ScaleInfo for every file in the library, skipping any
+// that fail to parse.
+func (l *Library) Index() ([]ScaleInfo, error) {
+	var infos []ScaleInfo
+	err := l.Walk(func(name string, s Scale, err error) error {
+		if err != nil {
+			return nil
+		}
+		cents, ratio := s.PitchCounts()
+		infos = append(infos, ScaleInfo{
+			Name:          name,
+			Description:   s.Description,
+			Degree:        s.Degree(),
+			CentsCount:    cents,
+			RatioCount:    ratio,
+			PerfectOctave: s.PerfectOctave(),
+		})
+		return nil
+	})
+	return infos, err
+}
@@ -0,0 +1,90 @@
+package scl
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+func writeFixture(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newTestLibrary(t *testing.T) Library {
+	dir := t.TempDir()
+	writeFixture(t, dir, "fifth.scl", "a perfect fifth\n 2\n 700.0\n 2/1\n")
+	// broken.scl is a directory, not a file, so opening and reading it as a
+	// scale fails with a genuine I/O error.
+	if err := os.Mkdir(filepath.Join(dir, "broken.scl"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return Library{Dir: dir}
+}
+
+func TestLibraryNames(t *testing.T) {
+	lib := newTestLibrary(t)
+	names, err := lib.Names()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(names)
+	if want := []string{"broken.scl", "fifth.scl"}; len(names) != len(want) || names[0] != want[0] || names[1] != want[1] {
+		t.Errorf("got %v, want %v", names, want)
+	}
+}
+
+func TestLibraryLoad(t *testing.T) {
+	lib := newTestLibrary(t)
+	s, err := lib.Load("fifth.scl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.Description, "a perfect fifth"; got != want {
+		t.Errorf("got description %q, want %q", got, want)
+	}
+	if got, want := s.Degree(), 2; got != want {
+		t.Errorf("got degree %d, want %d", got, want)
+	}
+
+	if _, err := lib.Load("does-not-exist.scl"); err == nil {
+		t.Error("got nil error loading a missing file, want an error")
+	}
+}
+
+func TestLibraryWalk(t *testing.T) {
+	lib := newTestLibrary(t)
+	var ok, failed int
+	err := lib.Walk(func(name string, s Scale, err error) error {
+		if err != nil {
+			failed++
+			return nil
+		}
+		ok++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok != 1 || failed != 1 {
+		t.Errorf("got ok=%d failed=%d, want ok=1 failed=1", ok, failed)
+	}
+}
+
+func TestLibraryIndex(t *testing.T) {
+	lib := newTestLibrary(t)
+	infos, err := lib.Index()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(infos) != 1 {
+		t.Fatalf("got %d ScaleInfos, want 1 (the broken file should be skipped)", len(infos))
+	}
+	info := infos[0]
+	if info.Name != "fifth.scl" || info.Degree != 2 || info.RatioCount != 1 || info.CentsCount != 1 || !info.PerfectOctave {
+		t.Errorf("got %+v, want fifth.scl with degree 2, 1 cents, 1 ratio, perfect octave", info)
+	}
+}
@@ -0,0 +1,113 @@
+package scl
+
+import (
+	"bytes"
+	"io"
+	"math"
+)
+
+// MTSBulkDump renders the scale as a MIDI Tuning Standard (MTS) Bulk Dump
+// sysex message, using base as the frequency of MIDI note 69 and name as
+// the 16-character tuning name. The scale repeats every s.Degree() MIDI
+// notes; to use a .kbm Mapping instead, call WriteMTS directly.
+func (s Scale) MTSBulkDump(base float64, name string) []byte {
+	var buf bytes.Buffer
+	s.WriteMTS(&buf, base, name, 0, 0, nil)
+	return buf.Bytes()
+}
+
+// WriteMTS writes a MIDI Tuning Standard Bulk Dump sysex message for the
+// scale to w, addressed to the given device and tuning program number. If
+// m is non-nil, the 128 tuning slots come from s.MIDIFreqs(*m); otherwise
+// base is the frequency of MIDI note 69 and the scale repeats every
+// s.Degree() notes.
+func (s Scale) WriteMTS(w io.Writer, base float64, name string, device, program int, m *Mapping) error {
+	_, err := w.Write(mtsBulkDump(device, program, name, s.mtsFreqs(base, m)))
+	return err
+}
+
+// MTSSingleNote renders a MIDI Tuning Standard Real-Time Single Note
+// Tuning Change sysex message that retunes note to the frequency base
+// would produce at that MIDI note in s, addressed to all devices using
+// channel as the tuning program number (MMA RP-020).
+func (s Scale) MTSSingleNote(channel, note int, base float64) []byte {
+	degree := s.Degree()
+	freq := base
+	if degree > 0 {
+		freq = base * s.ratio(note-69, degree)
+	}
+	b := mtsFreqBytes(freq)
+	return []byte{
+		0xF0, 0x7F, 0x7F, 0x08, 0x02, byte(channel & 0x7F),
+		0x01, byte(note & 0x7F), b[0], b[1], b[2],
+		0xF7,
+	}
+}
+
+// mtsFreqs returns the frequency to use for every MIDI note, either from a
+// keyboard mapping or by repeating the scale from MIDI note 69 = base.
+func (s Scale) mtsFreqs(base float64, m *Mapping) [128]float64 {
+	if m != nil {
+		return s.MIDIFreqs(*m)
+	}
+	var freqs [128]float64
+	degree := s.Degree()
+	for note := range freqs {
+		if degree == 0 {
+			freqs[note] = base
+			continue
+		}
+		freqs[note] = base * s.ratio(note-69, degree)
+	}
+	return freqs
+}
+
+// mtsBulkDump assembles a complete MTS Bulk Dump sysex message from 128
+// frequencies: F0 7E <device> 08 01 <program> <16-byte name> <128*3 bytes
+// tuning data> <checksum> F7.
+func mtsBulkDump(device, program int, name string, freqs [128]float64) []byte {
+	msg := make([]byte, 0, 6+16+128*3+2)
+	msg = append(msg, 0xF0, 0x7E, byte(device&0x7F), 0x08, 0x01, byte(program&0x7F))
+	msg = append(msg, mtsName(name)...)
+	for _, f := range freqs {
+		b := mtsFreqBytes(f)
+		msg = append(msg, b[0], b[1], b[2])
+	}
+	var checksum byte
+	for _, b := range msg[1:] {
+		checksum ^= b
+	}
+	msg = append(msg, checksum&0x7F, 0xF7)
+	return msg
+}
+
+// mtsName returns name as exactly 16 ASCII bytes, space-padded or
+// truncated as required by the MTS bulk dump format.
+func mtsName(name string) []byte {
+	b := bytes.Repeat([]byte{' '}, 16)
+	copy(b, name)
+	return b
+}
+
+// mtsFreqBytes encodes f as the MIDI semitone at or below it (0-127) plus
+// a 14-bit fraction of a semitone above that, MSB first in two 7-bit bytes,
+// as used by both the bulk dump and single note tuning change messages.
+func mtsFreqBytes(f float64) [3]byte {
+	note := 69 + 12*math.Log2(f/440)
+	switch {
+	case note < 0:
+		note = 0
+	case note > 127:
+		note = 127
+	}
+	semitone := int(math.Floor(note))
+	if semitone > 127 {
+		semitone = 127
+	}
+	frac := note - float64(semitone)
+	val := int(math.Round(frac * 16384))
+	if val >= 16384 {
+		val = 16383
+	}
+	return [3]byte{byte(semitone), byte((val >> 7) & 0x7F), byte(val & 0x7F)}
+}
@@ -0,0 +1,79 @@
+package scl
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMtsFreqBytes(t *testing.T) {
+	tests := []struct {
+		freq float64
+		want [3]byte
+	}{
+		{440, [3]byte{69, 0, 0}},
+		{440 * math.Exp2(0.5/12), [3]byte{69, 64, 0}},
+	}
+	for _, tt := range tests {
+		if got := mtsFreqBytes(tt.freq); got != tt.want {
+			t.Errorf("mtsFreqBytes(%v) = % x, want % x", tt.freq, got, tt.want)
+		}
+	}
+}
+
+func TestMTSBulkDump(t *testing.T) {
+	s := Scale{Pitches: []Pitch{CentsPitch(1200)}}
+	dump := s.MTSBulkDump(440, "Test Tuning")
+
+	const wantLen = 6 + 16 + 128*3 + 2
+	if len(dump) != wantLen {
+		t.Fatalf("got length %d, want %d", len(dump), wantLen)
+	}
+	if dump[0] != 0xF0 || dump[len(dump)-1] != 0xF7 {
+		t.Errorf("got frame % x .. % x, want F0 .. F7", dump[0], dump[len(dump)-1])
+	}
+	wantHeader := []byte{0xF0, 0x7E, 0x00, 0x08, 0x01, 0x00}
+	for i, b := range wantHeader {
+		if dump[i] != b {
+			t.Errorf("header byte %d: got %#x, want %#x", i, dump[i], b)
+		}
+	}
+	if got, want := string(dump[6:22]), "Test Tuning     "; got != want {
+		t.Errorf("got name %q, want %q", got, want)
+	}
+
+	var checksum byte
+	for _, b := range dump[1 : len(dump)-2] {
+		checksum ^= b
+	}
+	if got, want := dump[len(dump)-2], checksum&0x7F; got != want {
+		t.Errorf("got checksum %#x, want %#x", got, want)
+	}
+
+	note69 := dump[6+16+69*3 : 6+16+69*3+3]
+	if want := (mtsFreqBytes(440)); note69[0] != want[0] || note69[1] != want[1] || note69[2] != want[2] {
+		t.Errorf("MIDI 69 tuning bytes = % x, want % x", note69, want)
+	}
+}
+
+func TestMTSSingleNote(t *testing.T) {
+	s := Scale{Pitches: []Pitch{CentsPitch(1200)}}
+	msg := s.MTSSingleNote(5, 69, 440)
+	want := []byte{0xF0, 0x7F, 0x7F, 0x08, 0x02, 0x05, 0x01, 0x45, 0x45, 0x00, 0x00, 0xF7}
+	if len(msg) != len(want) {
+		t.Fatalf("got length %d, want %d", len(msg), len(want))
+	}
+	for i := range want {
+		if msg[i] != want[i] {
+			t.Errorf("byte %d: got %#x, want %#x", i, msg[i], want[i])
+		}
+	}
+}
+
+func TestMtsName(t *testing.T) {
+	if got, want := string(mtsName("short")), "short           "; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := string(mtsName("a very long tuning name")), "a very long tuni"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,66 @@
+package scl
+
+import "math"
+
+// Cents returns the pitch size in cents.
+func (p RatioPitch) Cents() float64 {
+	return 1200 * math.Log2(float64(p.N)/float64(p.D))
+}
+
+// Ratio returns the pitch's own ratio; epsilon is ignored since a
+// RatioPitch is already exact.
+func (p RatioPitch) Ratio(epsilon float64) (n, d int64) {
+	return p.N, p.D
+}
+
+// Cents returns the pitch size in cents.
+func (p CentsPitch) Cents() float64 {
+	return float64(p)
+}
+
+// Ratio returns a rational approximation of p, accurate to within epsilon
+// cents. See ApproximateRatio for the algorithm used.
+func (p CentsPitch) Ratio(epsilon float64) (n, d int64) {
+	r := ApproximateRatio(float64(p), epsilon)
+	return r.N, r.D
+}
+
+// ApproximateRatio finds small integers n, d such that the pitch n/d is
+// within epsilon cents of the given number of cents, using the
+// continued-fraction algorithm. It returns the first convergent that is
+// close enough; semiconvergents are not tried.
+func ApproximateRatio(cents, epsilon float64) RatioPitch {
+	if cents == 0 {
+		return RatioPitch{1, 1}
+	}
+	n, d := convergent(cents, epsilon)
+	return RatioPitch{n, d}
+}
+
+// convergent returns a continued-fraction convergent n/d of 2^(cents/1200)
+// whose own cents value is within epsilon cents of cents, stopping early
+// if the next convergent's numerator or denominator would overflow int64.
+func convergent(cents, epsilon float64) (n, d int64) {
+	x := math.Exp2(cents / 1200)
+	hPrev2, hPrev1 := 0.0, 1.0
+	kPrev2, kPrev1 := 1.0, 0.0
+	for i := 0; i < 64; i++ {
+		a := math.Floor(x)
+		h := a*hPrev1 + hPrev2
+		k := a*kPrev1 + kPrev2
+		if h >= float64(math.MaxInt64) || k >= float64(math.MaxInt64) {
+			break
+		}
+		hPrev2, hPrev1 = hPrev1, h
+		kPrev2, kPrev1 = kPrev1, k
+		if k != 0 && math.Abs(1200*math.Log2(h/k)-cents) <= epsilon {
+			break
+		}
+		frac := x - a
+		if frac <= 0 {
+			break
+		}
+		x = 1 / frac
+	}
+	return int64(hPrev1), int64(kPrev1)
+}
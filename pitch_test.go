@@ -0,0 +1,58 @@
+package scl
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRatioPitchCents(t *testing.T) {
+	p := RatioPitch{5, 4}
+	if got, want := p.Cents(), 386.3137138648348; math.Abs(got-want) > 0.0001 {
+		t.Errorf("got %f, want %f", got, want)
+	}
+}
+
+func TestRatioPitchRatio(t *testing.T) {
+	p := RatioPitch{5, 4}
+	n, d := p.Ratio(0.01)
+	if n != 5 || d != 4 {
+		t.Errorf("got %d/%d, want 5/4", n, d)
+	}
+}
+
+func TestApproximateRatio(t *testing.T) {
+	tests := []struct {
+		cents        float64
+		epsilon      float64
+		wantN, wantD int64
+	}{
+		{0, 0.01, 1, 1},
+		{386.3137, 0.01, 5, 4},
+		{700, 2, 3, 2},
+		{1200, 0.01, 2, 1},
+	}
+	for _, tt := range tests {
+		r := ApproximateRatio(tt.cents, tt.epsilon)
+		if r.N != tt.wantN || r.D != tt.wantD {
+			t.Errorf("ApproximateRatio(%v, %v) = %d/%d, want %d/%d", tt.cents, tt.epsilon, r.N, r.D, tt.wantN, tt.wantD)
+		}
+	}
+}
+
+func TestApproximateRatioNegative(t *testing.T) {
+	r := ApproximateRatio(-100, 0.01)
+	if r.N == 0 || r.D == 0 || r.N >= r.D {
+		t.Errorf("got %d/%d, want a ratio below unison", r.N, r.D)
+	}
+	if got := math.Abs(r.Cents() - (-100)); got > 0.01 {
+		t.Errorf("approximation off by %f cents, want <= 0.01", got)
+	}
+}
+
+func TestCentsPitchRatio(t *testing.T) {
+	p := CentsPitch(701.9550008653874)
+	n, d := p.Ratio(0.01)
+	if n != 3 || d != 2 {
+		t.Errorf("got %d/%d, want 3/2", n, d)
+	}
+}
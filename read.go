@@ -0,0 +1,132 @@
+package scl
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// A ParseError describes a malformed line encountered while reading a
+// Scale, including the 1-based line number and the offending text.
+type ParseError struct {
+	Line int
+	Text string
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %q: %s", e.Line, e.Text, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ScaleWarnings collects the non-fatal ParseErrors encountered while
+// reading a Scale permissively.
+type ScaleWarnings []ParseError
+
+func (w ScaleWarnings) Error() string {
+	if len(w) == 1 {
+		return w[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more)", w[0].Error(), len(w)-1)
+}
+
+// ReadStrict reads a Scale from the given reader like Read, but returns on
+// the first malformed line instead of skipping it. The returned error is a
+// *ParseError when the failure is a malformed line rather than an I/O
+// error.
+func ReadStrict(r io.Reader) (Scale, error) {
+	scale, _, err := readScale(r, true)
+	return scale, err
+}
+
+// ReadWithWarnings reads a Scale from the given reader like Read, and
+// additionally returns a ParseError for every malformed line that was
+// skipped.
+func ReadWithWarnings(r io.Reader) (Scale, ScaleWarnings, error) {
+	return readScale(r, false)
+}
+
+// readScale implements Read, ReadStrict and ReadWithWarnings. In strict
+// mode it returns immediately on the first malformed line; otherwise it
+// skips the line and records a ParseError in the returned warnings.
+func readScale(r io.Reader, strict bool) (Scale, ScaleWarnings, error) {
+	var (
+		scale      Scale
+		warnings   ScaleWarnings
+		readDesc   bool
+		readNum    bool
+		numPitches int64 = -1 // -1: count unknown, don't enforce or early-stop
+	)
+	d := newDataScanner(r)
+	for {
+		line, i, ok := d.next()
+		if !ok {
+			break
+		}
+		if !readDesc {
+			scale.Description = line
+			readDesc = true
+			continue
+		}
+		if !readNum {
+			n, err := parsePitchCount(line)
+			if err == nil && n < 0 {
+				err = fmt.Errorf("negative number of pitches: %d", n)
+			}
+			if err != nil {
+				perr := &ParseError{Line: i, Text: line, Err: err}
+				if strict {
+					return scale, warnings, perr
+				}
+				warnings = append(warnings, *perr)
+			} else {
+				numPitches = n
+			}
+			readNum = true
+			continue
+		}
+		pitch, err := parsePitch(line)
+		if err != nil {
+			perr := &ParseError{Line: i, Text: line, Err: err}
+			if strict {
+				return scale, warnings, perr
+			}
+			warnings = append(warnings, *perr)
+			continue
+		}
+		scale.Pitches = append(scale.Pitches, pitch)
+		if numPitches >= 0 && int64(len(scale.Pitches)) >= numPitches {
+			break // ignore any trailing garbage or blank lines at EOF
+		}
+	}
+	if err := d.err(); err != nil {
+		return scale, warnings, err
+	}
+	if numPitches >= 0 && len(warnings) == 0 && int64(len(scale.Pitches)) != numPitches {
+		err := fmt.Errorf("read %d pitches but expected %d", len(scale.Pitches), numPitches)
+		if strict {
+			return scale, warnings, err
+		}
+		warnings = append(warnings, ParseError{Err: err})
+	}
+	return scale, warnings, nil
+}
+
+// parsePitchCount parses the pitch count line, tolerating trailing
+// whitespace or garbage after the number as found in some real-world
+// files.
+func parsePitchCount(line string) (int64, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("malformed number of pitches: %q", line)
+	}
+	n, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("malformed number of pitches: %q", fields[0])
+	}
+	return n, nil
+}
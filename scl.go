@@ -4,6 +4,7 @@ package scl
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"io"
 	"math"
@@ -33,6 +34,13 @@ type Pitch interface {
 	// Freq returns the pitch frequency relative to the given base.
 	Freq(base float64) float64
 
+	// Cents returns the pitch size in cents.
+	Cents() float64
+
+	// Ratio returns a rational approximation n/d of the pitch, accurate to
+	// within epsilon cents.
+	Ratio(epsilon float64) (n, d int64)
+
 	String() string
 }
 
@@ -60,43 +68,52 @@ func (p CentsPitch) Freq(f float64) float64 {
 	return f * math.Exp2(float64(p)/1200.0)
 }
 
-// Read reads a Scale from the given reader.
+// Read reads a Scale from the given reader, skipping over malformed lines.
 // The input is assumed to be a file in scl format and is consumed until EOF is reached.
 func Read(r io.Reader) (Scale, error) {
-	var (
-		scale      Scale
-		readDesc   bool
-		readNum    bool
-		numPitches int64
-		err        error
-	)
-	s := bufio.NewScanner(r)
-	for i := 1; s.Scan(); i++ {
-		line := s.Text()
-		if strings.HasPrefix(line, "!") {
+	scale, _, err := readScale(r, false)
+	return scale, err
+}
+
+// dataScanner scans the line-oriented Scala file formats (.scl and .kbm),
+// skipping comment lines that begin with "!" and blank lines, and
+// tolerating a leading UTF-8 BOM and CRLF line endings -- all of which
+// appear in real-world files from the Huygens-Fokker archive.
+type dataScanner struct {
+	s    *bufio.Scanner
+	line int
+}
+
+func newDataScanner(r io.Reader) *dataScanner {
+	return &dataScanner{s: bufio.NewScanner(stripBOM(r))}
+}
+
+// stripBOM removes a leading UTF-8 byte order mark from r, if present.
+func stripBOM(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	bom, err := br.Peek(3)
+	if err == nil && bytes.Equal(bom, []byte{0xEF, 0xBB, 0xBF}) {
+		br.Discard(3)
+	}
+	return br
+}
+
+// next returns the next non-comment line along with its 1-based line number
+// in the underlying file, or ok == false once the scanner is exhausted.
+func (d *dataScanner) next() (line string, lineNum int, ok bool) {
+	for d.s.Scan() {
+		d.line++
+		line := strings.TrimSuffix(d.s.Text(), "\r")
+		if strings.HasPrefix(line, "!") || strings.TrimSpace(line) == "" {
 			continue
-		} else if !readDesc {
-			scale.Description = line
-			readDesc = true
-		} else if !readNum {
-			line = strings.TrimSpace(line)
-			numPitches, err = strconv.ParseInt(line, 10, 64)
-			if err != nil {
-				return scale, fmt.Errorf("malformed number of pitches: %s", line)
-			}
-			readNum = true
-		} else {
-			pitch, err := parsePitch(line)
-			if err != nil {
-				fmt.Errorf("Parse error on line %d: %s", i, err)
-			}
-			scale.Pitches = append(scale.Pitches, pitch)
 		}
+		return line, d.line, true
 	}
-	if len(scale.Pitches) != int(numPitches) {
-		return scale, fmt.Errorf("read %d pitches but expected %d", len(scale.Pitches), numPitches)
-	}
-	return scale, s.Err()
+	return "", d.line, false
+}
+
+func (d *dataScanner) err() error {
+	return d.s.Err()
 }
 
 // Write writes a scale to the given writer in scl format.
@@ -6,6 +6,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -71,28 +72,58 @@ func TestMeanquar(t *testing.T) {
 	}
 }
 
-func TestCorpus(t *testing.T) {
-	dir, err := os.Open(corpus)
+// TestReadBlankLines covers real-world files that have extra blank lines
+// mixed in with the pitch list, which previously panicked parsePitch.
+func TestReadBlankLines(t *testing.T) {
+	const blank = "desc\n 3\n 100.0\n\n 200.0\n 2/1\n\n\n"
+	s, err := Read(strings.NewReader(blank))
 	if err != nil {
 		t.Fatal(err)
 	}
-	names, err := dir.Readdirnames(-1)
+	if got, want := len(s.Pitches), 3; got != want {
+		t.Errorf("got %d pitches, want %d", got, want)
+	}
+}
+
+const malformedPitch = "desc\n 3\n 100.0\n bogus\n 2/1\n"
+
+func TestReadStrict(t *testing.T) {
+	_, err := ReadStrict(strings.NewReader(malformedPitch))
+	pe, ok := err.(*ParseError)
+	if !ok {
+		t.Fatalf("got error %v (%T), want a *ParseError", err, err)
+	}
+	if pe.Line != 4 || pe.Text != " bogus" {
+		t.Errorf("got ParseError{Line: %d, Text: %q}, want {Line: 4, Text: \" bogus\"}", pe.Line, pe.Text)
+	}
+}
+
+func TestReadWithWarnings(t *testing.T) {
+	s, warnings, err := ReadWithWarnings(strings.NewReader(malformedPitch))
 	if err != nil {
-		t.Fatal(err)
+		t.Fatalf("ReadWithWarnings: %v", err)
 	}
-	for _, name := range names {
-		f, err := os.Open(filepath.Join(corpus, name))
-		if err != nil {
-			t.Error(err)
-			continue
-		}
-		scale, err := Read(f)
+	if len(warnings) != 1 || warnings[0].Line != 4 {
+		t.Fatalf("got warnings %v, want one ParseError at line 4", warnings)
+	}
+	if got, want := len(s.Pitches), 2; got != want {
+		t.Errorf("got %d pitches, want %d", got, want)
+	}
+}
+
+func TestCorpus(t *testing.T) {
+	lib := Library{Dir: corpus}
+	err := lib.Walk(func(name string, scale Scale, err error) error {
 		if err != nil {
 			t.Errorf("Couldn't read %s: %s", name, err)
+			return nil
 		}
 		if len(scale.Description) == 0 {
 			t.Errorf("%s: 0 length description", name)
 		}
-		f.Close()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
 	}
 }